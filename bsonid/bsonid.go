@@ -0,0 +1,54 @@
+// Package bsonid adds MongoDB BSON marshaling support for objectid.ID. It is
+// kept separate from the objectid package so that callers who only need the
+// hex or base32-hex representations are not forced to depend on
+// go.mongodb.org/mongo-driver.
+package bsonid
+
+import (
+	"fmt"
+
+	"github.com/pkg-id/objectid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ID wraps objectid.ID to implement bson.ValueMarshaler and bson.ValueUnmarshaler,
+// encoding it as the native MongoDB ObjectId BSON type (0x07) instead of a string.
+type ID objectid.ID
+
+var (
+	_ bson.ValueMarshaler   = ID{}
+	_ bson.ValueUnmarshaler = (*ID)(nil)
+)
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (id ID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.ObjectID, id[:], nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (id *ID) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t != bsontype.ObjectID {
+		return fmt.Errorf("bsonid: invalid BSON type %s for ID", t)
+	}
+	if len(data) != len(*id) {
+		return fmt.Errorf("bsonid: invalid ObjectId length %d", len(data))
+	}
+	copy(id[:], data)
+	return nil
+}
+
+// ToPrimitive converts an objectid.ID into a MongoDB driver primitive.ObjectID.
+//
+// This is a package function rather than a method on ID, since ID belongs to
+// the objectid package and methods can only be declared alongside their
+// type's definition.
+func ToPrimitive(id objectid.ID) primitive.ObjectID {
+	return primitive.ObjectID(id)
+}
+
+// FromPrimitive converts a MongoDB driver primitive.ObjectID into an objectid.ID.
+func FromPrimitive(oid primitive.ObjectID) objectid.ID {
+	return objectid.ID(oid)
+}
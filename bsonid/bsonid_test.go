@@ -0,0 +1,100 @@
+package bsonid_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg-id/objectid"
+	"github.com/pkg-id/objectid/bsonid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestID_MarshalBSONValue_UnmarshalBSONValue(t *testing.T) {
+	type doc struct {
+		ID bsonid.ID `bson:"_id"`
+	}
+
+	id := bsonid.ID(objectid.New())
+	data, err := bson.Marshal(doc{ID: id})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var decoded doc
+	if err := bson.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if decoded.ID != id {
+		t.Errorf("expect the ids are equal")
+	}
+}
+
+func TestToPrimitive_FromPrimitive(t *testing.T) {
+	id := objectid.New()
+
+	oid := bsonid.ToPrimitive(id)
+	if primitive.ObjectID(id) != oid {
+		t.Errorf("expect ToPrimitive to preserve the underlying bytes")
+	}
+
+	if bsonid.FromPrimitive(oid) != id {
+		t.Errorf("expect FromPrimitive to round-trip back to the original ID")
+	}
+}
+
+// TestID_MongoCollection_RoundTrip inserts an objectid.ID into a real mongo
+// collection and reads it back both as a primitive.ObjectID and as an
+// objectid.ID, exercising the interop path against the actual driver instead
+// of an in-memory bson.Marshal/Unmarshal. Set MONGODB_URI to run it; it is
+// skipped otherwise since no mongod is available in most environments.
+func TestID_MongoCollection_RoundTrip(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("set MONGODB_URI to run the round-trip test against a real mongod")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("bsonid_test").Collection("round_trip")
+	defer coll.Drop(ctx)
+
+	type doc struct {
+		ID bsonid.ID `bson:"_id"`
+	}
+
+	id := objectid.New()
+	if _, err := coll.InsertOne(ctx, doc{ID: bsonid.ID(id)}); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	var asPrimitive struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := coll.FindOne(ctx, bson.M{"_id": bsonid.ID(id)}).Decode(&asPrimitive); err != nil {
+		t.Fatalf("find as primitive.ObjectID: %v", err)
+	}
+	if asPrimitive.ID != bsonid.ToPrimitive(id) {
+		t.Errorf("expect the read-back primitive.ObjectID to match the inserted id")
+	}
+
+	var asObjectID doc
+	if err := coll.FindOne(ctx, bson.M{"_id": bsonid.ID(id)}).Decode(&asObjectID); err != nil {
+		t.Fatalf("find as objectid.ID: %v", err)
+	}
+	if objectid.ID(asObjectID.ID) != id {
+		t.Errorf("expect the read-back objectid.ID to match the inserted id")
+	}
+}
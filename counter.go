@@ -0,0 +1,131 @@
+package objectid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Counter generates the counter portion of an ID.
+type Counter interface {
+	// Next returns the next counter value.
+	Next() uint32
+
+	// NextForEpoch returns the next counter value for the given unix epoch
+	// second, plus the epoch the caller must embed alongside it. The returned
+	// epoch is normally epochs unchanged, but an implementation that runs out
+	// of counter space within epochs returns an advanced epoch instead, so
+	// that the (epoch, counter) pair handed back never repeats.
+	NextForEpoch(epochs int64) (effectiveEpochs int64, value uint32)
+}
+
+// AtomicCounter is the default Counter implementation: a process-wide atomic
+// value, seeded once at startup, that increases on every call regardless of
+// the epoch it is called with.
+type AtomicCounter uint32
+
+// NewSecureCounter generates a new secure counter value for generating ID.
+func NewSecureCounter(reader io.Reader) (Counter, error) {
+	var buf [4]byte // ensure for 32-bit.
+	_, err := io.ReadFull(reader, buf[:])
+	if err != nil {
+		return nil, fmt.Errorf("generate initial counter: %w", err)
+	}
+	c := AtomicCounter(binary.BigEndian.Uint32(buf[:]))
+	return &c, nil
+}
+
+// Next returns the next value of the counter.
+func (c *AtomicCounter) Next() uint32 { return atomic.AddUint32((*uint32)(c), 1) }
+
+// NextForEpoch returns the next value of the counter and epochs unchanged;
+// AtomicCounter never needs to advance the epoch since it doesn't track
+// per-second state.
+func (c *AtomicCounter) NextForEpoch(epochs int64) (int64, uint32) { return epochs, c.Next() }
+
+// counterMax is the largest value the 24-bit counter portion of an ID can hold.
+const counterMax = 1<<24 - 1
+
+// MonotonicCounter is a Counter that guarantees the value it returns increases
+// monotonically within a single wall-clock second, and only resets when the
+// epoch it is called with advances to a new second. This avoids the counter
+// regression NewSecureCounter can exhibit when two processes start within the
+// same second.
+type MonotonicCounter struct {
+	// ResetOnTick controls what happens when the epoch advances: if true, the
+	// counter reseeds from a random 24-bit value; if false, it keeps counting
+	// up from its previous value.
+	ResetOnTick bool
+
+	// OnOverflow, if non-nil, is called with the current epoch when the 24-bit
+	// counter would wrap within a single second. Whether or not it is set, the
+	// counter then bumps its epoch by one second and continues counting from
+	// zero, which is equivalent to the embedded timestamp advancing early.
+	OnOverflow func(epochs int64)
+
+	mu    sync.Mutex
+	epoch int64
+	value uint32
+}
+
+// Next returns the next counter value for the current time. It satisfies the
+// bare Counter.Next contract but discards the effective epoch NextForEpoch
+// computes, so a caller that only ever calls Next will not see the
+// overflow-driven epoch advance reflected anywhere: the invariant
+// MonotonicCounter exists to provide only holds for callers that use
+// NextForEpoch and embed its returned epoch, as New and NewEpochs do. Prefer
+// NextForEpoch directly when that invariant matters.
+func (c *MonotonicCounter) Next() uint32 {
+	_, value := c.NextForEpoch(time.Now().Unix())
+	return value
+}
+
+// NextForEpoch returns the next counter value for the given unix epoch second,
+// and the epoch the caller must embed alongside it. If the 24-bit counter
+// would wrap within epochs, NextForEpoch bumps its internal epoch by one
+// second and returns that instead, so the (epoch, counter) pair handed back
+// to the caller never repeats; OnOverflow, if set, is notified first.
+func (c *MonotonicCounter) NextForEpoch(epochs int64) (int64, uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case epochs > c.epoch:
+		c.epoch = epochs
+		if c.ResetOnTick {
+			c.value = randomCounterSeed()
+		} else {
+			c.value++
+		}
+	case epochs < c.epoch:
+		// The clock moved backwards; keep counting from the current epoch
+		// instead of regressing the counter.
+		c.value++
+	default:
+		c.value++
+	}
+
+	if c.value > counterMax {
+		if c.OnOverflow != nil {
+			c.OnOverflow(c.epoch)
+		}
+		c.epoch++
+		c.value = 0
+	}
+
+	return c.epoch, c.value
+}
+
+// randomCounterSeed returns a random 24-bit counter seed, or 0 if the global
+// crypto/rand source is unavailable.
+func randomCounterSeed() uint32 {
+	var buf [4]byte
+	if _, err := io.ReadFull(rand.Reader, buf[:]); err != nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(buf[:]) & counterMax
+}
@@ -0,0 +1,70 @@
+package objectid_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/objectid"
+)
+
+func TestMonotonicCounter_SameEpoch(t *testing.T) {
+	var c objectid.MonotonicCounter
+
+	_, first := c.NextForEpoch(100)
+	_, second := c.NextForEpoch(100)
+
+	if second != first+1 {
+		t.Errorf("expect the counter to increase by one within the same epoch, got %d then %d", first, second)
+	}
+}
+
+func TestMonotonicCounter_NewEpoch_NoReset(t *testing.T) {
+	c := objectid.MonotonicCounter{ResetOnTick: false}
+
+	_, first := c.NextForEpoch(100)
+	epoch, second := c.NextForEpoch(101)
+
+	if epoch != 101 {
+		t.Errorf("expect the effective epoch to be 101, got %d", epoch)
+	}
+	if second != first+1 {
+		t.Errorf("expect the counter to keep increasing across epochs when ResetOnTick is false, got %d then %d", first, second)
+	}
+}
+
+func TestMonotonicCounter_NewEpoch_Reset(t *testing.T) {
+	c := objectid.MonotonicCounter{ResetOnTick: true}
+
+	c.NextForEpoch(100)
+	_, second := c.NextForEpoch(101)
+
+	if second == 0 {
+		t.Errorf("expect the counter to reseed to a non-zero value on tick")
+	}
+}
+
+func TestMonotonicCounter_Overflow(t *testing.T) {
+	c := objectid.MonotonicCounter{}
+
+	var overflowed int64 = -1
+	c.OnOverflow = func(epochs int64) { overflowed = epochs }
+
+	seen := make(map[[2]int64]struct{})
+	epoch, value := c.NextForEpoch(100)
+	seen[[2]int64{epoch, int64(value)}] = struct{}{}
+
+	for i := uint32(0); i < 1<<24; i++ {
+		epoch, value = c.NextForEpoch(100)
+		key := [2]int64{epoch, int64(value)}
+		if _, ok := seen[key]; ok {
+			t.Fatalf("expect every (epoch, counter) pair to be unique, got duplicate epoch=%d value=%d", epoch, value)
+		}
+		seen[key] = struct{}{}
+	}
+
+	if overflowed != 100 {
+		t.Errorf("expect OnOverflow to be called with the epoch it overflowed in, got %d", overflowed)
+	}
+	if epoch != 101 {
+		t.Errorf("expect the effective epoch to advance past the overflowed second, got %d", epoch)
+	}
+}
@@ -0,0 +1,129 @@
+package objectid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Encoding identifies a string representation supported by ID.
+type Encoding int
+
+const (
+	// EncodingHex represents the ID as 24 lowercase hexadecimal characters.
+	EncodingHex Encoding = iota
+
+	// EncodingBase32Hex represents the ID as 20 lowercase base32-hex characters
+	// (alphabet 0-9a-v, no padding), which sorts lexicographically the same as
+	// the underlying bytes while being 4 characters shorter than hex.
+	EncodingBase32Hex
+)
+
+// DefaultEncoding controls the representation produced by String, MarshalText,
+// MarshalJSON, and Value. Decode, UnmarshalText, UnmarshalJSON, and Scan always
+// accept both encodings regardless of this setting.
+var DefaultEncoding = EncodingHex
+
+// base32HexAlphabet is the lowercase base32-hex alphabet, which preserves the
+// lexicographic ordering of the encoded bytes, unlike the standard base32 alphabet.
+const base32HexAlphabet = "0123456789abcdefghijklmnopqrstuv"
+
+// Base32 returns the base32-hex representation of the ID: 20 lowercase characters
+// from the alphabet 0-9a-v, without padding.
+func (id ID) Base32() string {
+	var out [20]byte
+	var buf uint32
+	bits := 0
+	oi := 0
+	for _, b := range id {
+		buf = buf<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out[oi] = base32HexAlphabet[(buf>>uint(bits))&0x1f]
+			oi++
+		}
+	}
+	if bits > 0 {
+		out[oi] = base32HexAlphabet[(buf<<uint(5-bits))&0x1f]
+		oi++
+	}
+	return string(out[:oi])
+}
+
+// DecodeBase32 decodes the base32-hex representation and returns the
+// corresponding ID.
+func DecodeBase32(s string) (ID, error) {
+	if len(s) != 20 {
+		return Nil, fmt.Errorf("decode base32hex: length is not 20 characters")
+	}
+
+	var id ID
+	var buf uint32
+	bits := 0
+	oi := 0
+	for i := 0; i < len(s); i++ {
+		v := base32HexIndex(s[i])
+		if v < 0 {
+			return Nil, fmt.Errorf("decode base32hex: invalid character %q", s[i])
+		}
+
+		buf = buf<<5 | uint32(v)
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			id[oi] = byte(buf >> uint(bits))
+			oi++
+		}
+	}
+
+	// The remaining bits are padding introduced by encoding 96 bits as 20
+	// groups of 5 (100 bits). Base32 only canonically encodes them as zero,
+	// so any input with non-zero padding bits decodes to an ID other than the
+	// one that would re-encode to it; reject it instead of silently
+	// accepting every one of the 16 strings that map to the same ID.
+	if buf&(1<<uint(bits)-1) != 0 {
+		return Nil, fmt.Errorf("decode base32hex: non-zero padding bits")
+	}
+
+	return id, nil
+}
+
+// base32HexIndex returns the value of c in the base32-hex alphabet, or -1 if c
+// is not a valid base32-hex character.
+func base32HexIndex(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'v':
+		return int(c-'a') + 10
+	default:
+		return -1
+	}
+}
+
+// Value implements the driver.Valuer interface.
+func (id ID) Value() (driver.Value, error) {
+	return id.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (id *ID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		decoded, err := Decode(v)
+		if err != nil {
+			return err
+		}
+		*id = decoded
+		return nil
+	case []byte:
+		decoded, err := Decode(string(v))
+		if err != nil {
+			return err
+		}
+		*id = decoded
+		return nil
+	default:
+		return fmt.Errorf("scan: unsupported source type %T", src)
+	}
+}
@@ -0,0 +1,80 @@
+package objectid_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/objectid"
+)
+
+func TestID_Base32_DecodeBase32(t *testing.T) {
+	id1 := objectid.New()
+	s := id1.Base32()
+	if len(s) != 20 {
+		t.Fatalf("expect base32 representation to be 20 characters, got %d", len(s))
+	}
+
+	id2, err := objectid.DecodeBase32(s)
+	if err != nil {
+		t.Fatalf("expect no error; got error %v", err)
+	}
+
+	if id1 != id2 {
+		t.Errorf("expect the ids are equal")
+	}
+}
+
+func TestDecodeBase32_RejectsNonZeroPadding(t *testing.T) {
+	s := objectid.New().Base32()
+
+	// Only the last character's top bit is significant; the other 4 bits are
+	// zero padding. Flip it to a character that sets one of those bits.
+	last := s[len(s)-1]
+	bad := byte('1')
+	if last == '1' {
+		bad = '2'
+	}
+	s = s[:len(s)-1] + string(bad)
+
+	if _, err := objectid.DecodeBase32(s); err == nil {
+		t.Errorf("expect an error for a base32-hex string with non-zero padding bits")
+	}
+}
+
+func TestDecode_AutoDetect(t *testing.T) {
+	id1 := objectid.New()
+
+	decodedHex, err := objectid.Decode(id1.String())
+	if err != nil {
+		t.Fatalf("expect no error; got error %v", err)
+	}
+	if decodedHex != id1 {
+		t.Errorf("expect the ids are equal")
+	}
+
+	decodedBase32, err := objectid.Decode(id1.Base32())
+	if err != nil {
+		t.Fatalf("expect no error; got error %v", err)
+	}
+	if decodedBase32 != id1 {
+		t.Errorf("expect the ids are equal")
+	}
+}
+
+func TestDefaultEncoding_Base32Hex(t *testing.T) {
+	prev := objectid.DefaultEncoding
+	defer func() { objectid.DefaultEncoding = prev }()
+
+	objectid.DefaultEncoding = objectid.EncodingBase32Hex
+	id := objectid.New()
+	if id.String() != id.Base32() {
+		t.Errorf("expect String to use the base32-hex encoding, got %s", id.String())
+	}
+
+	b, err := id.MarshalJSON()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(b) != `"`+id.Base32()+`"` {
+		t.Errorf("expect MarshalJSON to use the base32-hex encoding, got %s", b)
+	}
+}
@@ -0,0 +1,97 @@
+package objectid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg-id/objectid"
+)
+
+func TestGenerator_NewBatch(t *testing.T) {
+	g := objectid.NewGenerator()
+	ids := g.NewBatch(100)
+
+	if len(ids) != 100 {
+		t.Fatalf("expect 100 ids, got %d", len(ids))
+	}
+
+	seen := make(map[objectid.ID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			t.Fatalf("expect every id in the batch to be unique, got duplicate %s", id)
+		}
+		seen[id] = struct{}{}
+	}
+}
+
+func TestNewBatch(t *testing.T) {
+	ids := objectid.NewBatch(100)
+
+	if len(ids) != 100 {
+		t.Fatalf("expect 100 ids, got %d", len(ids))
+	}
+
+	seen := make(map[objectid.ID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			t.Fatalf("expect every id in the batch to be unique, got duplicate %s", id)
+		}
+		seen[id] = struct{}{}
+	}
+}
+
+func TestGenerator_NewInto(t *testing.T) {
+	g := objectid.NewGenerator()
+
+	var buf [len(objectid.ID{})]byte
+	n := g.NewInto(buf[:])
+	if n != len(buf) {
+		t.Fatalf("expect NewInto to write %d bytes, got %d", len(buf), n)
+	}
+
+	var id objectid.ID
+	copy(id[:], buf[:])
+	if id.IsZero() {
+		t.Errorf("expect NewInto to write a non-zero id")
+	}
+}
+
+func TestGenerator_RefreshesAcrossSeconds(t *testing.T) {
+	g := objectid.NewGenerator()
+	first := g.NewBatch(1)[0]
+
+	time.Sleep(time.Until(time.Unix(first.Timestamp().Unix()+1, 0)) + 50*time.Millisecond)
+
+	second := g.NewBatch(1)[0]
+	if !second.Timestamp().After(first.Timestamp()) {
+		t.Errorf("expect the generator to refresh its cached timestamp across a wall-clock second boundary")
+	}
+}
+
+func TestGenerator_NewInto_PanicsOnShortBuffer(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expect NewInto to panic when dst is shorter than len(ID)")
+		}
+	}()
+
+	g := objectid.NewGenerator()
+	var buf [11]byte
+	g.NewInto(buf[:])
+}
+
+func BenchmarkNew(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = objectid.New()
+	}
+}
+
+func BenchmarkGenerator_NewBatch(b *testing.B) {
+	g := objectid.NewGenerator()
+	const batchSize = 1000
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i += batchSize {
+		_ = g.NewBatch(batchSize)
+	}
+}
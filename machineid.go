@@ -0,0 +1,69 @@
+package objectid
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"os"
+)
+
+// MachineID is the machine portion of the MachineProcessID.
+type MachineID [3]byte
+
+// ProcessID is the process portion of the MachineProcessID.
+type ProcessID [2]byte
+
+// MachineID returns the machine identifier portion of the ID.
+func (id ID) MachineID() MachineID {
+	var m MachineID
+	copy(m[:], id[timestampSize:timestampSize+len(m)])
+	return m
+}
+
+// ProcessID returns the process identifier portion of the ID.
+func (id ID) ProcessID() ProcessID {
+	var p ProcessID
+	copy(p[:], id[timestampSize+len(MachineID{}):timestampSize+processSize])
+	return p
+}
+
+// NewHostMachineProcessID derives a MachineProcessID from the current host instead
+// of a random source, so that IDs generated on the same host share a machine
+// identifier and can be traced back to it. The machine identifier is the first 3
+// bytes of the MD5 digest of os.Hostname (falling back to SHA-256 in FIPS-restricted
+// environments where MD5 is unavailable), and the process identifier is the current
+// process id in big-endian order.
+func NewHostMachineProcessID() (MachineProcessID, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return MachineProcessID{}, fmt.Errorf("read hostname: %w", err)
+	}
+
+	var process MachineProcessID
+	copy(process[:3], hashMachineID(hostname))
+	binary.BigEndian.PutUint16(process[3:], uint16(os.Getpid()))
+	return process, nil
+}
+
+// hashMachineID hashes hostname into a 3-byte machine identifier.
+func hashMachineID(hostname string) []byte {
+	if sum, ok := md5Sum(hostname); ok {
+		return sum[:3]
+	}
+	sum := sha256.Sum256([]byte(hostname))
+	return sum[:3]
+}
+
+// md5Sum computes the MD5 digest of s, reporting ok=false instead of panicking
+// when MD5 is unavailable, e.g. under a FIPS-restricted crypto provider.
+func md5Sum(s string) (sum [md5.Size]byte, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	sum = md5.Sum([]byte(s))
+	ok = true
+	return sum, ok
+}
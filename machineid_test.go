@@ -0,0 +1,45 @@
+package objectid_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/objectid"
+)
+
+func TestNewHostMachineProcessID(t *testing.T) {
+	pid1, err := objectid.NewHostMachineProcessID()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	pid2, err := objectid.NewHostMachineProcessID()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if pid1 != pid2 {
+		t.Errorf("expect the host machine process id to be stable across calls")
+	}
+}
+
+func TestID_MachineID_ProcessID(t *testing.T) {
+	pid, err := objectid.NewHostMachineProcessID()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	objectid.SetMachineAndProcessID(pid)
+	id := objectid.New()
+
+	var wantMachineID objectid.MachineID
+	copy(wantMachineID[:], pid[:3])
+	if id.MachineID() != wantMachineID {
+		t.Errorf("expect MachineID %v, got %v", wantMachineID, id.MachineID())
+	}
+
+	var wantProcessID objectid.ProcessID
+	copy(wantProcessID[:], pid[3:])
+	if id.ProcessID() != wantProcessID {
+		t.Errorf("expect ProcessID %v, got %v", wantProcessID, id.ProcessID())
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"io"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 )
 
@@ -64,18 +63,27 @@ func New() ID {
 	return NewEpochs(epochs)
 }
 
-// NewEpochs same as New but with given epochs.
+// NewEpochs same as New but with given epochs. The timestamp embedded in the
+// returned ID is the effective epoch reported by the counter, which can be
+// later than epochs if the counter had to advance to avoid overflowing within
+// a single second.
 func NewEpochs(epochs int64) ID {
 	var id ID
-	binary.BigEndian.PutUint32(id[:timestampSize], uint32(epochs))
+	effectiveEpochs, count := counter.NextForEpoch(epochs)
+	binary.BigEndian.PutUint32(id[:timestampSize], uint32(effectiveEpochs))
 	copy(id[timestampSize:timestampSize+processSize], machineProcessID[:])
-	putBigEndianUint24(id[timestampSize+processSize:], counter.Next())
+	putBigEndianUint24(id[timestampSize+processSize:], count)
 	return id
 }
 
-// String returns a string representation of the ID.
+// String returns a string representation of the ID, using DefaultEncoding.
 func (id ID) String() string {
-	return hex.EncodeToString(id[:])
+	switch DefaultEncoding {
+	case EncodingBase32Hex:
+		return id.Base32()
+	default:
+		return hex.EncodeToString(id[:])
+	}
 }
 
 // Timestamp returns the timestamp portion of the ID as a time.Time object.
@@ -129,11 +137,21 @@ func (id *ID) UnmarshalJSON(data []byte) error {
 }
 
 // Decode decodes the string representation and returns the corresponding ID.
+// It accepts both the hex (24 characters) and base32-hex (20 characters)
+// representations regardless of DefaultEncoding.
 func Decode(s string) (ID, error) {
-	if len(s) != 24 {
-		return Nil, errors.New("length is not 24 bytes")
+	switch len(s) {
+	case 24:
+		return decodeHex(s)
+	case 20:
+		return DecodeBase32(s)
+	default:
+		return Nil, errors.New("length is not 20 or 24 bytes")
 	}
+}
 
+// decodeHex decodes the hex representation and returns the corresponding ID.
+func decodeHex(s string) (ID, error) {
 	b, err := hex.DecodeString(s)
 	if err != nil {
 		return Nil, fmt.Errorf("decode hex: %w", err)
@@ -144,23 +162,6 @@ func Decode(s string) (ID, error) {
 	return id, nil
 }
 
-// Counter is the implementation of the counter in ObjectID.
-type Counter uint32
-
-// NewSecureCounter generates a new secure counter value for generating ID.
-func NewSecureCounter(reader io.Reader) (Counter, error) {
-	var buf [4]byte // ensure for 32-byte.
-	_, err := io.ReadFull(reader, buf[:])
-	if err != nil {
-		return 0, fmt.Errorf("generate initial counter: %w", err)
-	}
-	n := binary.BigEndian.Uint32(buf[:])
-	return Counter(n), nil
-}
-
-// Next returns the next value of the counter.
-func (c *Counter) Next() uint32 { return atomic.AddUint32((*uint32)(c), 1) }
-
 // MachineProcessID is the implementation of the machine and process id portion for the ObjectID.
 type MachineProcessID [processSize]byte
 